@@ -4,6 +4,8 @@ import { useStore } from './store';
 import Login from './pages/Login';
 import Register from './pages/Register';
 import Dashboard from './pages/Dashboard';
+import MagicLinkCallback from './pages/MagicLinkCallback';
+import OAuthCallback from './pages/OAuthCallback';
 
 function PrivateRoute({ children }: { children: React.ReactNode }) {
   const { isAuthenticated, isAuthLoading } = useStore();
@@ -65,6 +67,22 @@ function App() {
             </PublicRoute>
           }
         />
+        <Route
+          path="/auth/magic-link"
+          element={
+            <PublicRoute>
+              <MagicLinkCallback />
+            </PublicRoute>
+          }
+        />
+        <Route
+          path="/auth/callback"
+          element={
+            <PublicRoute>
+              <OAuthCallback />
+            </PublicRoute>
+          }
+        />
         <Route
           path="/"
           element={
@@ -0,0 +1,51 @@
+import { useState } from 'react';
+import { useStore } from '../../store';
+
+// Blocks the dashboard until the user accepts newly published
+// terms-of-service / privacy-policy versions. Renders nothing when the
+// instance does not enforce acceptance or the user is up to date.
+export default function PolicyGate() {
+  const { policyStatus, acceptPolicies } = useStore();
+  const [isLoading, setIsLoading] = useState(false);
+
+  if (!policyStatus?.mustAccept) return null;
+
+  const handleAccept = async () => {
+    setIsLoading(true);
+    try {
+      await acceptPolicies();
+    } catch (error) {
+      console.error('Failed to accept policies:', error);
+      alert('Failed to record your acceptance. Please try again.');
+    } finally {
+      setIsLoading(false);
+    }
+  };
+
+  return (
+    <div className="fixed inset-0 bg-black bg-opacity-50 flex items-center justify-center z-50 p-4">
+      <div className="bg-white dark:bg-gray-800 rounded-lg shadow-xl max-w-md w-full p-6">
+        <h2 className="text-lg font-normal text-gray-900 dark:text-gray-100 mb-3">
+          Updated terms
+        </h2>
+        <p className="text-sm text-gray-700 dark:text-gray-300 mb-2">
+          Our terms of service{policyStatus.current.termsOfService && (
+            <span> (version {policyStatus.current.termsOfService})</span>
+          )} and privacy policy{policyStatus.current.privacyPolicy && (
+            <span> (version {policyStatus.current.privacyPolicy})</span>
+          )} have been updated.
+        </p>
+        <p className="text-sm text-gray-500 dark:text-gray-400 mb-6">
+          You need to accept the new versions before making further changes.
+        </p>
+        <button
+          onClick={handleAccept}
+          disabled={isLoading}
+          className="w-full px-6 py-2.5 bg-primary-600 text-white rounded-lg hover:bg-primary-700 disabled:opacity-50 disabled:cursor-not-allowed transition"
+        >
+          {isLoading ? 'Saving...' : 'Accept and continue'}
+        </button>
+      </div>
+    </div>
+  );
+}
@@ -1,9 +1,10 @@
 import { X } from 'lucide-react';
 import { useStore } from '../../store';
 import { formatDistanceToNow, format } from 'date-fns';
+import { getLocalizedTitle, getLocalizedDescription } from '../../utils/localization';
 
 export default function DetailPanel() {
-  const { selectedItem, isDetailPanelOpen, closeDetailPanel, updateItem, deleteItem, categories, circles } = useStore();
+  const { selectedItem, isDetailPanelOpen, closeDetailPanel, updateItem, deleteItem, categories, circles, getPlanConflicts, rsvpItem, user } = useStore();
 
   if (!isDetailPanelOpen || !selectedItem) {
     return null;
@@ -18,6 +19,14 @@ export default function DetailPanel() {
     : undefined;
   const itemCircles = itemCircle ? [itemCircle] : [];
 
+  // Check whether this item clashes with other plans in the same circle
+  const conflict = getPlanConflicts().find(group =>
+    group.items.some(conflictItem => conflictItem.id === selectedItem.id)
+  );
+  const conflictingTitles = conflict
+    ? conflict.items.filter(conflictItem => conflictItem.id !== selectedItem.id).map(conflictItem => conflictItem.title)
+    : [];
+
   const handleDelete = async () => {
     if (confirm('Are you sure you want to delete this item?')) {
       await deleteItem(selectedItem.id);
@@ -64,7 +73,7 @@ export default function DetailPanel() {
 
         {/* Title */}
         <h3 className="text-lg font-normal text-gray-900 dark:text-gray-100 mb-5">
-          {selectedItem.title}
+          {getLocalizedTitle(selectedItem)}
         </h3>
 
         {/* Details */}
@@ -141,6 +150,11 @@ export default function DetailPanel() {
               <div className="text-sm text-gray-900 dark:text-gray-100">
                 {format(new Date(selectedItem.dueDate), 'MMM d, yyyy')}
               </div>
+              {conflictingTitles.length > 0 && (
+                <div className="mt-2 p-2 bg-amber-50 dark:bg-amber-900/20 border border-amber-200 dark:border-amber-800 rounded-lg text-xs text-amber-700 dark:text-amber-400">
+                  ⚠️ Same day as {conflictingTitles.join(', ')} in this circle
+                </div>
+              )}
             </div>
           )}
 
@@ -156,6 +170,69 @@ export default function DetailPanel() {
             </div>
           )}
 
+          {/* RSVP (event items) */}
+          {selectedItem.event && (() => {
+            const goingCount = selectedItem.event.rsvps.filter(rsvp => rsvp.status === 'going').length;
+            const waitlistCount = selectedItem.event.rsvps.filter(rsvp => rsvp.status === 'waitlist').length;
+            const myRsvp = selectedItem.event.rsvps.find(rsvp => rsvp.userId === user?.id);
+            const isFull = selectedItem.event.capacity !== undefined && goingCount >= selectedItem.event.capacity;
+
+            return (
+              <div>
+                <div className="text-xs font-medium text-gray-500 dark:text-gray-400 uppercase tracking-wide mb-2">
+                  RSVP
+                </div>
+                <div className="text-sm text-gray-900 dark:text-gray-100 mb-2">
+                  {goingCount} going
+                  {selectedItem.event.capacity !== undefined && ` of ${selectedItem.event.capacity}`}
+                  {waitlistCount > 0 && ` · ${waitlistCount} waitlisted`}
+                  {isFull && ' · Full'}
+                </div>
+                {myRsvp && (
+                  <div className="text-xs text-gray-500 dark:text-gray-400 mb-2">
+                    You are {myRsvp.status === 'going' ? 'going' : myRsvp.status === 'waitlist' ? 'on the waitlist' : 'not going'}
+                  </div>
+                )}
+                <div className="flex gap-2">
+                  <button
+                    onClick={() => rsvpItem(selectedItem.id, 'going')}
+                    className={`px-3 py-1.5 text-xs rounded-lg border transition ${myRsvp?.status === 'going' || myRsvp?.status === 'waitlist'
+                      ? 'border-primary-600 bg-primary-50 dark:bg-primary-900 text-primary-700 dark:text-primary-300'
+                      : 'border-gray-300 dark:border-gray-600 text-gray-700 dark:text-gray-300 hover:border-primary-600'
+                      }`}
+                  >
+                    {isFull && myRsvp?.status !== 'going' ? 'Join waitlist' : "I'm going"}
+                  </button>
+                  <button
+                    onClick={() => rsvpItem(selectedItem.id, 'declined')}
+                    className={`px-3 py-1.5 text-xs rounded-lg border transition ${myRsvp?.status === 'declined'
+                      ? 'border-gray-400 bg-gray-100 dark:bg-gray-700 text-gray-700 dark:text-gray-300'
+                      : 'border-gray-300 dark:border-gray-600 text-gray-700 dark:text-gray-300 hover:border-gray-400'
+                      }`}
+                  >
+                    Can't make it
+                  </button>
+                </div>
+              </div>
+            );
+          })()}
+
+          {/* Expense */}
+          {selectedItem.expense && (
+            <div>
+              <div className="text-xs font-medium text-gray-500 dark:text-gray-400 uppercase tracking-wide mb-2">
+                Expense
+              </div>
+              <div className="text-sm text-gray-900 dark:text-gray-100">
+                {selectedItem.expense.amount.toFixed(2)} {selectedItem.expense.currency}
+                <span className="text-gray-500 dark:text-gray-400">
+                  {' '}split between {selectedItem.expense.participantIds.length}
+                  {selectedItem.expense.payerId === user?.id ? ', paid by you' : ''}
+                </span>
+              </div>
+            </div>
+          )}
+
           {/* Tags */}
           {selectedItem.tags && selectedItem.tags.length > 0 && (
             <div>
@@ -176,13 +253,13 @@ export default function DetailPanel() {
           )}
 
           {/* Description */}
-          {selectedItem.description && (
+          {getLocalizedDescription(selectedItem) && (
             <div>
               <div className="text-xs font-medium text-gray-500 dark:text-gray-400 uppercase tracking-wide mb-2">
                 Description
               </div>
               <div className="text-sm text-gray-700 dark:text-gray-300 leading-relaxed">
-                {selectedItem.description}
+                {getLocalizedDescription(selectedItem)}
               </div>
             </div>
           )}
@@ -4,9 +4,10 @@ import { HobbyItem } from '../../types';
 import { formatDistanceToNow } from 'date-fns';
 import { MoreVertical } from 'lucide-react';
 import { getBadgeClasses } from '../../config/colors';
+import { getLocalizedTitle } from '../../utils/localization';
 import { FontAwesomeIcon } from '@fortawesome/react-fontawesome';
-import { faInstagram, faYoutube, faXTwitter, faTiktok, faTelegram, faWikipediaW } from '@fortawesome/free-brands-svg-icons';
-import { faGlobe, faPen } from '@fortawesome/free-solid-svg-icons';
+import { faInstagram, faYoutube, faXTwitter, faTiktok, faTelegram, faSpotify, faWikipediaW } from '@fortawesome/free-brands-svg-icons';
+import { faGlobe, faPen, faRss } from '@fortawesome/free-solid-svg-icons';
 
 export default function ItemList() {
   const {
@@ -65,6 +66,8 @@ export default function ItemList() {
       twitter: faXTwitter,
       tiktok: faTiktok,
       telegram: faTelegram,
+      spotify: faSpotify,
+      rss: faRss,
       web: faGlobe,
       manual: faPen,
       wikipedia: faWikipediaW,
@@ -112,7 +115,7 @@ export default function ItemList() {
         {/* Content */}
         <div className="flex-1 min-w-0">
           <div className={`text-sm mb-1.5 ${item.isCompleted ? 'line-through text-gray-400 dark:text-gray-500' : 'text-gray-900 dark:text-gray-100'}`}>
-            {item.title}
+            {getLocalizedTitle(item)}
           </div>
 
           {/* Aligned Badge Grid */}
@@ -3,8 +3,8 @@ import { useStore } from '../../store';
 import { DataSource } from '../../types';
 import { getSidebarFilterClasses } from '../../config/colors';
 import { FontAwesomeIcon } from '@fortawesome/react-fontawesome';
-import { faInstagram, faYoutube, faXTwitter, faTiktok, faTelegram, faWikipediaW } from '@fortawesome/free-brands-svg-icons';
-import { faGlobe, faPen } from '@fortawesome/free-solid-svg-icons';
+import { faInstagram, faYoutube, faXTwitter, faTiktok, faTelegram, faSpotify, faWikipediaW } from '@fortawesome/free-brands-svg-icons';
+import { faGlobe, faPen, faRss } from '@fortawesome/free-solid-svg-icons';
 import type { IconDefinition } from '@fortawesome/fontawesome-svg-core';
 
 export default function Sidebar() {
@@ -31,6 +31,8 @@ export default function Sidebar() {
     { value: 'twitter', label: 'X (Twitter)', icon: faXTwitter },
     { value: 'tiktok', label: 'TikTok', icon: faTiktok },
     { value: 'telegram', label: 'Telegram', icon: faTelegram },
+    { value: 'spotify', label: 'Spotify', icon: faSpotify },
+    { value: 'rss', label: 'RSS', icon: faRss },
     { value: 'web', label: 'Web', icon: faGlobe },
     { value: 'manual', label: 'Manual', icon: faPen },
     { value: 'wikipedia', label: 'Wikipedia', icon: faWikipediaW },
@@ -59,6 +59,7 @@ export default function ImportModal() {
     if (text.includes('twitter.com') || text.includes('x.com')) return 'twitter';
     if (text.includes('tiktok.com')) return 'tiktok';
     if (text.includes('t.me')) return 'telegram';
+    if (text.includes('open.spotify.com') || text.includes('spotify.link')) return 'spotify';
     if (text.includes('wikipedia.org')) return 'wikipedia';
     if (text.startsWith('http')) return 'web';
     return 'manual';
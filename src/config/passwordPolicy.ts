@@ -0,0 +1,81 @@
+// Configurable password policy.
+//
+// The instance's rules come from the backend config (served alongside other
+// instance settings); the hard-coded defaults here match the backend
+// defaults so client-side validation agrees with the server.
+
+export interface PasswordPolicy {
+  minLength: number;
+  requireUppercase: boolean;
+  requireDigit: boolean;
+  requireSymbol: boolean;
+  checkBreached: boolean; // k-anonymity lookup against haveibeenpwned
+}
+
+// One entry per violated rule, so forms can show all problems at once
+export interface PasswordValidationError {
+  rule: 'minLength' | 'uppercase' | 'digit' | 'symbol' | 'breached';
+  message: string;
+}
+
+export const defaultPasswordPolicy: PasswordPolicy = {
+  minLength: 8,
+  requireUppercase: false,
+  requireDigit: false,
+  requireSymbol: false,
+  checkBreached: false,
+};
+
+/**
+ * Validate a password against the policy's offline rules.
+ * Returns an empty array when the password passes.
+ */
+export function validatePassword(
+  password: string,
+  policy: PasswordPolicy = defaultPasswordPolicy
+): PasswordValidationError[] {
+  const errors: PasswordValidationError[] = [];
+
+  if (password.length < policy.minLength) {
+    errors.push({
+      rule: 'minLength',
+      message: `Password must be at least ${policy.minLength} characters`,
+    });
+  }
+  if (policy.requireUppercase && !/[A-Z]/.test(password)) {
+    errors.push({ rule: 'uppercase', message: 'Password must contain an uppercase letter' });
+  }
+  if (policy.requireDigit && !/\d/.test(password)) {
+    errors.push({ rule: 'digit', message: 'Password must contain a digit' });
+  }
+  if (policy.requireSymbol && !/[^A-Za-z0-9]/.test(password)) {
+    errors.push({ rule: 'symbol', message: 'Password must contain a symbol' });
+  }
+
+  return errors;
+}
+
+/**
+ * Check the password against known breaches using the k-anonymity range
+ * API: only the first 5 chars of the SHA-1 hash leave the browser.
+ * Fails open (returns false) if the service is unreachable.
+ */
+export async function isPasswordBreached(password: string): Promise<boolean> {
+  try {
+    const digest = await crypto.subtle.digest('SHA-1', new TextEncoder().encode(password));
+    const hash = Array.from(new Uint8Array(digest))
+      .map((byte) => byte.toString(16).padStart(2, '0'))
+      .join('')
+      .toUpperCase();
+    const prefix = hash.slice(0, 5);
+    const suffix = hash.slice(5);
+
+    const response = await fetch(`https://api.pwnedpasswords.com/range/${prefix}`);
+    if (!response.ok) return false;
+
+    const body = await response.text();
+    return body.split('\n').some((line) => line.split(':')[0] === suffix);
+  } catch {
+    return false;
+  }
+}
@@ -3,6 +3,7 @@ import Sidebar from '../components/layout/Sidebar';
 import ItemList from '../components/items/ItemList';
 import DetailPanel from '../components/items/DetailPanel';
 import ImportModal from '../components/modals/ImportModal';
+import PolicyGate from '../components/auth/PolicyGate';
 import { useStore } from '../store';
 
 export default function Dashboard() {
@@ -17,6 +18,7 @@ export default function Dashboard() {
         {isDetailPanelOpen && <DetailPanel />}
       </div>
       <ImportModal />
+      <PolicyGate />
     </div>
   );
 }
@@ -4,11 +4,12 @@ import { useStore } from '../store';
 
 export default function Login() {
   const navigate = useNavigate();
-  const { login, oauthLogin } = useStore();
+  const { login, oauthLogin, requestMagicLink, continueAsGuest } = useStore();
   const [email, setEmail] = useState('');
   const [password, setPassword] = useState('');
   const [isLoading, setIsLoading] = useState(false);
   const [error, setError] = useState('');
+  const [magicLinkSent, setMagicLinkSent] = useState(false);
 
   const handleSubmit = async (e: React.FormEvent) => {
     e.preventDefault();
@@ -25,6 +26,36 @@ export default function Login() {
     }
   };
 
+  const handleMagicLink = async () => {
+    if (!email) {
+      setError('Enter your email to receive a sign-in link');
+      return;
+    }
+
+    setError('');
+    setIsLoading(true);
+    try {
+      await requestMagicLink(email);
+      setMagicLinkSent(true);
+    } catch (err) {
+      setError('Failed to send sign-in link');
+    } finally {
+      setIsLoading(false);
+    }
+  };
+
+  const handleGuest = async () => {
+    try {
+      setIsLoading(true);
+      await continueAsGuest();
+      navigate('/');
+    } catch (err) {
+      setError('Failed to start a guest session');
+    } finally {
+      setIsLoading(false);
+    }
+  };
+
   const handleOAuthLogin = async (provider: 'google' | 'apple' | 'twitter') => {
     try {
       setIsLoading(true);
@@ -155,6 +186,22 @@ export default function Login() {
             >
               {isLoading ? 'Signing in...' : 'Sign in'}
             </button>
+
+            {/* Passwordless option */}
+            {magicLinkSent ? (
+              <p className="text-center text-sm text-green-600 dark:text-green-400">
+                Check your email for a sign-in link.
+              </p>
+            ) : (
+              <button
+                type="button"
+                onClick={handleMagicLink}
+                disabled={isLoading}
+                className="w-full py-2.5 text-sm text-primary-600 dark:text-primary-400 hover:text-primary-700 dark:hover:text-primary-300 font-medium transition disabled:opacity-50"
+              >
+                Email me a sign-in link instead
+              </button>
+            )}
           </form>
 
           {/* Footer */}
@@ -164,6 +211,16 @@ export default function Login() {
               Sign up
             </Link>
           </div>
+          <div className="mt-2 text-center text-sm">
+            <button
+              type="button"
+              onClick={handleGuest}
+              disabled={isLoading}
+              className="text-gray-500 dark:text-gray-400 hover:text-gray-700 dark:hover:text-gray-300 transition disabled:opacity-50"
+            >
+              Try it as a guest
+            </button>
+          </div>
         </div>
       </div>
     </div>
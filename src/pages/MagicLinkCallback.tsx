@@ -0,0 +1,46 @@
+import { useEffect, useState } from 'react';
+import { useNavigate, useSearchParams, Link } from 'react-router-dom';
+import { useStore } from '../store';
+
+// Landing page for the single-use sign-in link sent by email.
+// Exchanges the link token for a session and redirects to the dashboard.
+export default function MagicLinkCallback() {
+  const navigate = useNavigate();
+  const [searchParams] = useSearchParams();
+  const { magicLinkLogin } = useStore();
+  const [error, setError] = useState('');
+
+  useEffect(() => {
+    const token = searchParams.get('token');
+    if (!token) {
+      setError('This sign-in link is missing its token.');
+      return;
+    }
+
+    magicLinkLogin(token)
+      .then(() => navigate('/'))
+      .catch(() => setError('This sign-in link is invalid or has expired. Please request a new one.'));
+  }, [searchParams, magicLinkLogin, navigate]);
+
+  return (
+    <div className="min-h-screen bg-gray-50 dark:bg-gray-900 flex items-center justify-center p-4">
+      <div className="max-w-md w-full text-center">
+        {error ? (
+          <>
+            <div className="mb-6 p-3 bg-red-50 dark:bg-red-900/20 border border-red-200 dark:border-red-800 rounded-lg text-sm text-red-600 dark:text-red-400">
+              {error}
+            </div>
+            <Link to="/login" className="text-sm text-primary-600 dark:text-primary-400 hover:text-primary-700 dark:hover:text-primary-300 font-medium">
+              Back to sign in
+            </Link>
+          </>
+        ) : (
+          <>
+            <div className="w-16 h-16 border-4 border-primary-600 border-t-transparent rounded-full animate-spin mx-auto mb-4"></div>
+            <p className="text-gray-600 dark:text-gray-400">Signing you in...</p>
+          </>
+        )}
+      </div>
+    </div>
+  );
+}
@@ -0,0 +1,47 @@
+import { useEffect, useState } from 'react';
+import { useNavigate, useSearchParams, Link } from 'react-router-dom';
+import { useStore } from '../store';
+
+// Landing page the backend redirects to after completing an OAuth flow.
+// Redeems the short-lived exchange code for a session and moves on to
+// the dashboard; the real token never appears in the URL or history.
+export default function OAuthCallback() {
+  const navigate = useNavigate();
+  const [searchParams] = useSearchParams();
+  const { exchangeAuthCode } = useStore();
+  const [error, setError] = useState('');
+
+  useEffect(() => {
+    const code = searchParams.get('code');
+    if (!code) {
+      setError(searchParams.get('error') || 'Sign-in was cancelled or the provider returned no code.');
+      return;
+    }
+
+    exchangeAuthCode(code)
+      .then(() => navigate('/'))
+      .catch(() => setError('Could not complete sign-in. Please try again.'));
+  }, [searchParams, exchangeAuthCode, navigate]);
+
+  return (
+    <div className="min-h-screen bg-gray-50 dark:bg-gray-900 flex items-center justify-center p-4">
+      <div className="max-w-md w-full text-center">
+        {error ? (
+          <>
+            <div className="mb-6 p-3 bg-red-50 dark:bg-red-900/20 border border-red-200 dark:border-red-800 rounded-lg text-sm text-red-600 dark:text-red-400">
+              {error}
+            </div>
+            <Link to="/login" className="text-sm text-primary-600 dark:text-primary-400 hover:text-primary-700 dark:hover:text-primary-300 font-medium">
+              Back to sign in
+            </Link>
+          </>
+        ) : (
+          <>
+            <div className="w-16 h-16 border-4 border-primary-600 border-t-transparent rounded-full animate-spin mx-auto mb-4"></div>
+            <p className="text-gray-600 dark:text-gray-400">Completing sign-in...</p>
+          </>
+        )}
+      </div>
+    </div>
+  );
+}
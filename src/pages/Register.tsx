@@ -1,6 +1,7 @@
 import { useState } from 'react';
 import { useNavigate, Link } from 'react-router-dom';
 import { useStore } from '../store';
+import { validatePassword, isPasswordBreached, defaultPasswordPolicy } from '../config/passwordPolicy';
 
 export default function Register() {
   const navigate = useNavigate();
@@ -21,13 +22,20 @@ export default function Register() {
       return;
     }
 
-    if (password.length < 6) {
-      setError('Password must be at least 6 characters');
+    const policyErrors = validatePassword(password);
+    if (policyErrors.length > 0) {
+      setError(policyErrors.map(policyError => policyError.message).join('. '));
       return;
     }
 
     setIsLoading(true);
 
+    if (defaultPasswordPolicy.checkBreached && await isPasswordBreached(password)) {
+      setError('This password has appeared in a data breach. Please choose a different one.');
+      setIsLoading(false);
+      return;
+    }
+
     try {
       await register(email, password, name);
       navigate('/');
@@ -1,12 +1,14 @@
 import { create } from 'zustand';
-import { HobbyItem, Category, Circle, User, Tag, DataSource } from '../types';
+import { HobbyItem, Category, Circle, User, Tag, DataSource, PolicyStatus, PlanConflict, TelegramLoginPayload } from '../types';
 import {
   authService,
+  accountService,
   itemsService,
   categoriesService,
   circlesService,
   tagsService,
-  importService
+  importService,
+  categoryWithDescendants
 } from '../services/api';
 import type { ImportRequest } from '../types';
 
@@ -15,6 +17,7 @@ interface AppStore {
   user: User | null;
   isAuthenticated: boolean;
   isAuthLoading: boolean;
+  policyStatus: PolicyStatus | null;
 
   // Data state
   items: HobbyItem[];
@@ -39,16 +42,30 @@ interface AppStore {
   login: (email: string, password: string) => Promise<void>;
   register: (email: string, password: string, name: string) => Promise<void>;
   oauthLogin: (provider: 'google' | 'apple' | 'twitter') => Promise<void>;
+  telegramLogin: (payload: TelegramLoginPayload) => Promise<void>;
+  continueAsGuest: () => Promise<void>;
+  requestMagicLink: (email: string) => Promise<void>;
+  linkProvider: (provider: 'google' | 'apple' | 'twitter') => Promise<void>;
+  changePassword: (currentPassword: string, newPassword: string) => Promise<void>;
+  updateProfile: (updates: Partial<Pick<User, 'name' | 'avatarUrl'>>) => Promise<void>;
+  uploadAvatar: (avatar: File) => Promise<void>;
+  magicLinkLogin: (linkToken: string) => Promise<void>;
+  exchangeAuthCode: (exchangeCode: string) => Promise<void>;
   logout: () => Promise<void>;
+  deleteAccount: () => Promise<void>;
   checkAuth: () => Promise<void>;
+  checkPolicyStatus: () => Promise<void>;
+  acceptPolicies: () => Promise<void>;
 
   // Item actions
   fetchItems: () => Promise<void>;
   getFilteredItems: () => HobbyItem[]; // Get items filtered by current filters
+  getPlanConflicts: () => PlanConflict[]; // Items in the same circle planned for the same day
   createItem: (item: Partial<HobbyItem>) => Promise<void>;
   updateItem: (id: string, updates: Partial<HobbyItem>) => Promise<void>;
   deleteItem: (id: string) => Promise<void>;
   toggleItemComplete: (id: string) => Promise<void>;
+  rsvpItem: (id: string, status: 'going' | 'declined') => Promise<void>;
 
   // Import actions
   importItem: (request: ImportRequest) => Promise<any>;
@@ -93,6 +110,7 @@ export const useStore = create<AppStore>((set, get) => ({
   user: null,
   isAuthenticated: false,
   isAuthLoading: true,
+  policyStatus: null,
   items: [],
   categories: [],
   circles: [],
@@ -161,6 +179,114 @@ export const useStore = create<AppStore>((set, get) => ({
     }
   },
 
+  telegramLogin: async (payload: TelegramLoginPayload) => {
+    try {
+      const { user, token } = await authService.telegramLogin(payload);
+      set({ user, isAuthenticated: true });
+      // Load user data after login
+      get().fetchItems();
+      get().fetchCategories();
+      get().fetchCircles();
+      get().fetchTags();
+    } catch (error) {
+      console.error('Telegram login failed:', error);
+      throw error;
+    }
+  },
+
+  continueAsGuest: async () => {
+    try {
+      const { user } = await authService.startGuestSession();
+      set({ user, isAuthenticated: true });
+      // Guests start from an empty slate but still need circles/categories
+      get().fetchItems();
+      get().fetchCategories();
+      get().fetchCircles();
+      get().fetchTags();
+    } catch (error) {
+      console.error('Failed to start guest session:', error);
+      throw error;
+    }
+  },
+
+  updateProfile: async (updates: Partial<Pick<User, 'name' | 'avatarUrl'>>) => {
+    try {
+      const user = await authService.updateProfile(updates);
+      set({ user });
+    } catch (error) {
+      console.error('Failed to update profile:', error);
+      throw error;
+    }
+  },
+
+  uploadAvatar: async (avatar: File) => {
+    try {
+      const user = await authService.uploadAvatar(avatar);
+      set({ user });
+    } catch (error) {
+      console.error('Failed to upload avatar:', error);
+      throw error;
+    }
+  },
+
+  changePassword: async (currentPassword: string, newPassword: string) => {
+    try {
+      await authService.changePassword(currentPassword, newPassword);
+    } catch (error) {
+      console.error('Failed to change password:', error);
+      throw error;
+    }
+  },
+
+  linkProvider: async (provider: 'google' | 'apple' | 'twitter') => {
+    try {
+      const user = await authService.linkProvider(provider);
+      set({ user });
+    } catch (error) {
+      console.error('Failed to link provider:', error);
+      throw error;
+    }
+  },
+
+  requestMagicLink: async (email: string) => {
+    try {
+      await authService.requestMagicLink(email);
+    } catch (error) {
+      console.error('Failed to request magic link:', error);
+      throw error;
+    }
+  },
+
+  magicLinkLogin: async (linkToken: string) => {
+    try {
+      const { user, token } = await authService.magicLinkLogin(linkToken);
+      set({ user, isAuthenticated: true });
+      // Load user data after login
+      get().fetchItems();
+      get().fetchCategories();
+      get().fetchCircles();
+      get().fetchTags();
+    } catch (error) {
+      console.error('Magic link login failed:', error);
+      throw error;
+    }
+  },
+
+  exchangeAuthCode: async (exchangeCode: string) => {
+    try {
+      const { user, token } = await authService.exchangeAuthCode(exchangeCode);
+      set({ user, isAuthenticated: true });
+      // Load user data after login
+      get().fetchItems();
+      get().fetchCategories();
+      get().fetchCircles();
+      get().fetchTags();
+    } catch (error) {
+      console.error('Auth code exchange failed:', error);
+      throw error;
+    }
+  },
+
   logout: async () => {
     try {
       await authService.logout();
@@ -180,6 +306,27 @@ export const useStore = create<AppStore>((set, get) => ({
     }
   },
 
+  deleteAccount: async () => {
+    try {
+      await accountService.deleteAccount();
+      set({
+        user: null,
+        isAuthenticated: false,
+        items: [],
+        categories: [],
+        circles: [],
+        tags: [],
+        selectedItem: null,
+        selectedCategoryTab: 'all',
+        selectedSources: [],
+        selectedCircles: [],
+      });
+    } catch (error) {
+      console.error('Failed to delete account:', error);
+      throw error;
+    }
+  },
+
   checkAuth: async () => {
     try {
       set({ isAuthLoading: true });
@@ -188,6 +335,7 @@ export const useStore = create<AppStore>((set, get) => ({
         set({ user, isAuthenticated: true });
         // Load user data
         await Promise.all([
+          get().checkPolicyStatus(),
           get().fetchItems(),
           get().fetchCategories(),
           get().fetchCircles(),
@@ -202,6 +350,27 @@ export const useStore = create<AppStore>((set, get) => ({
     }
   },
 
+  checkPolicyStatus: async () => {
+    try {
+      const policyStatus = await authService.getPolicyStatus();
+      set({ policyStatus });
+    } catch (error) {
+      console.error('Failed to check policy status:', error);
+    }
+  },
+
+  acceptPolicies: async () => {
+    const { policyStatus } = get();
+    if (!policyStatus) return;
+    try {
+      const updated = await authService.acceptPolicies(policyStatus.current);
+      set({ policyStatus: updated });
+    } catch (error) {
+      console.error('Failed to accept policies:', error);
+      throw error;
+    }
+  },
+
   // Item actions
   fetchItems: async () => {
     try {
@@ -218,9 +387,14 @@ export const useStore = create<AppStore>((set, get) => ({
   getFilteredItems: () => {
     const { items, categories, selectedCategoryTab, selectedSources, selectedCircles } = get();
 
+    // Selecting a category includes everything nested under it
+    const categoryIds = selectedCategoryTab !== 'all'
+      ? categoryWithDescendants(categories, selectedCategoryTab)
+      : null;
+
     return items.filter(item => {
       // Filter by category tab
-      if (selectedCategoryTab !== 'all' && item.categoryId !== selectedCategoryTab) {
+      if (categoryIds && !categoryIds.has(item.categoryId)) {
         return false;
       }
 
@@ -242,6 +416,27 @@ export const useStore = create<AppStore>((set, get) => ({
     });
   },
 
+  getPlanConflicts: () => {
+    const { items, categories } = get();
+
+    // Group open items with a due date by circle and day
+    const byCircleAndDay: Record<string, PlanConflict> = {};
+    items.forEach(item => {
+      if (!item.dueDate || item.isCompleted) return;
+      const category = categories.find(cat => cat.id === item.categoryId);
+      if (!category) return;
+
+      const date = new Date(item.dueDate);
+      const key = `${category.circleId}|${date.toDateString()}`;
+      if (!byCircleAndDay[key]) {
+        byCircleAndDay[key] = { circleId: category.circleId, date, items: [] };
+      }
+      byCircleAndDay[key].items.push({ id: item.id, title: item.title });
+    });
+
+    return Object.values(byCircleAndDay).filter(group => group.items.length > 1);
+  },
+
   createItem: async (item: Partial<HobbyItem>) => {
     try {
       const newItem = await itemsService.createItem(item);
@@ -292,6 +487,19 @@ export const useStore = create<AppStore>((set, get) => ({
     }
   },
 
+  rsvpItem: async (id: string, status: 'going' | 'declined') => {
+    try {
+      const updatedItem = await itemsService.rsvp(id, status);
+      set({
+        items: get().items.map(item => item.id === id ? updatedItem : item),
+        selectedItem: get().selectedItem?.id === id ? updatedItem : get().selectedItem,
+      });
+    } catch (error) {
+      console.error('Failed to RSVP:', error);
+      throw error;
+    }
+  },
+
   // Import actions
   importItem: async (request: ImportRequest) => {
     try {
@@ -18,26 +18,165 @@ export type DataSource =
     | 'twitter'
     | 'tiktok'
     | 'telegram'
+    | 'spotify'
+    | 'rss'
     | 'web'
     | 'manual'
     | 'wikipedia';
 
 export type AccessLevel = 'private' | 'view' | 'edit' | 'admin';
 
+export type UserRole = 'user' | 'admin';
+
 export interface User {
     id: string;
     email: string;
     name: string;
+    role?: UserRole; // Defaults to 'user'; admins get operator endpoints
+    isDisabled?: boolean;
+    isGuest?: boolean; // Anonymous session; data merges into a real account on upgrade
     avatarUrl?: string;
     provider?: 'google' | 'apple' | 'twitter' | 'email';
+    linkedProviders?: ('google' | 'apple' | 'twitter')[]; // Additional connected OAuth providers
+    lastLoginAt?: Date;
     createdAt: Date;
 }
 
+// Versions of the legal documents published for this instance.
+// Configured server-side; empty/absent versions mean acceptance is not enforced.
+export interface PolicyVersions {
+    termsOfService: string;
+    privacyPolicy: string;
+}
+
+export interface PolicyStatus {
+    current: PolicyVersions;
+    accepted?: {
+        termsOfServiceVersion: string;
+        privacyPolicyVersion: string;
+        acceptedAt: Date;
+    };
+    mustAccept: boolean; // True when a newer version was published; writes are blocked until accepted
+}
+
 export interface Tag {
     id: string;
     name: string;
     color?: string;
     usageCount: number;
+    parentId?: string; // hierarchical tags, e.g. "asian" > "japanese"
+}
+
+// A tag with its children resolved, as returned by the tag tree endpoint
+export interface TagTreeNode extends Tag {
+    children: TagTreeNode[];
+}
+
+// Optional richer lifecycle for items. isCompleted stays the source of
+// truth for "done" so the existing toggle keeps working; status refines
+// the not-yet-done side.
+export type ItemStatus = 'backlog' | 'planned' | 'in-progress' | 'done' | 'abandoned';
+
+export type ItemPriority = 'low' | 'medium' | 'high';
+
+// One entry of an item's embedded checklist (e.g. a packing list
+// inside a travel item)
+export interface ChecklistEntry {
+    id: string;
+    title: string;
+    done: boolean;
+    position: number;
+}
+
+// Fields the item list endpoint can sort on; each is backed by a
+// compound index with the pagination key
+export type ItemSortField = 'addedAt' | 'dueDate' | 'title' | 'completedAt' | 'priority';
+
+// A file attached to an item (photo, menu, ticket). Thumbnails are
+// generated server-side for image types.
+export interface ItemAttachment {
+    id: string;
+    itemId: string;
+    fileName: string;
+    contentType: string;
+    size: number; // bytes
+    url: string;
+    thumbnailUrl?: string;
+    uploadedBy: string; // User ID
+    uploadedAt: Date;
+}
+
+// How two linked items relate to each other
+export type ItemLinkType = 'related' | 'based-on' | 'near' | 'pairs-with';
+
+// A typed link between two items (e.g. a movie and the book it's
+// based on, or a restaurant near a travel destination)
+export interface ItemLink {
+    id: string;
+    fromItemId: string;
+    toItemId: string;
+    type: ItemLinkType;
+    createdBy: string; // User ID
+    createdAt: Date;
+}
+
+// A linked item as embedded in the item detail response
+export interface LinkedItemSummary {
+    linkId: string;
+    type: ItemLinkType;
+    item: HobbyItem;
+}
+
+// One full-text search hit, with the matched field snippets so clients
+// can highlight them
+export interface ItemSearchResult {
+    item: HobbyItem;
+    score: number;
+    highlights: { field: 'title' | 'description' | 'tags'; snippet: string }[];
+}
+
+// Versioned JSON interchange document for bulk import/export. The
+// version gates parsing so old exports stay importable as the format
+// evolves.
+export interface InterchangeDocument {
+    version: 1;
+    exportedAt: Date;
+    categories: Category[];
+    items: HobbyItem[];
+    tags: Tag[];
+}
+
+// Outcome of a bulk JSON import
+export interface BulkImportSummary {
+    createdCategories: number;
+    createdItems: number;
+    createdTags: number;
+    skippedDuplicates: number;
+    errors: string[];
+}
+
+// One bucket of the grouped item listing: a category with its items
+// and counts, as rendered on the dashboard
+export interface CategoryItemsGroup {
+    category: Category;
+    items: HobbyItem[];
+    totalCount: number;
+    completedCount: number;
+}
+
+// One page of the item list; nextCursor is absent on the last page
+export interface ItemPage {
+    items: HobbyItem[];
+    total: number;
+    nextCursor?: string;
+}
+
+// One expected metadata field declared by a category's schema
+// (e.g. Movies: director, year; Restaurants: cuisine, price range)
+export interface CategoryMetadataField {
+    name: string;
+    type: 'string' | 'number' | 'boolean';
+    required?: boolean;
 }
 
 export interface Category {
@@ -45,25 +184,121 @@ export interface Category {
     name: string;
     icon: string;
     circleId: string; // Circle ID (including 'circle-personal' for personal items)
+    parentId?: string; // Parent category for nesting, max depth 3
     ownerId: string;
+    archivedAt?: Date; // Finished lists are hidden, not deleted
+    metadataSchema?: CategoryMetadataField[]; // Validated against item metadata on create/update
+    statusWorkflow?: ItemStatus[]; // Statuses this category's items may use; unset allows all
     itemCount: number;
     createdAt: Date;
     updatedAt: Date;
 }
 
+// Aggregated progress numbers for one category, for progress views
+export interface CategoryStats {
+    totalItems: number;
+    completedItems: number;
+    completionRate: number; // 0-1
+    itemsPerMonth: { month: string; count: number }[]; // 'YYYY-MM', ascending
+    topTags: { tag: string; count: number }[];
+}
+
+// A category with its children resolved, as returned by the tree endpoint
+export interface CategoryTreeNode extends Category {
+    children: CategoryTreeNode[];
+}
+
+// Optional per-language variants of an item's text fields,
+// keyed by BCP-47 language tag (e.g. 'en', 'fa', 'de-AT')
+export interface ItemLocalization {
+    title?: string;
+    description?: string;
+}
+
+export type RsvpStatus = 'going' | 'waitlist' | 'declined';
+
+export interface EventRsvp {
+    userId: string;
+    status: RsvpStatus;
+    respondedAt: Date;
+}
+
+// Event details for items that are capacity-limited gatherings
+// (concert tickets, escape room bookings, etc.)
+export interface ItemEvent {
+    capacity?: number; // Undefined means unlimited
+    rsvps: EventRsvp[];
+}
+
+// Split-the-bill record attached to a completed item
+export interface ItemExpense {
+    amount: number;
+    currency: string; // ISO 4217 code, e.g. 'USD'
+    payerId: string; // User who paid
+    participantIds: string[]; // Users sharing the cost (including the payer)
+}
+
+// Fields extracted from a receipt photo by the backend OCR pipeline
+// (config-gated: local tesseract or a cloud provider)
+export interface ReceiptScanResult {
+    amount?: number;
+    currency?: string;
+    merchant?: string;
+    confidence: number; // 0-1
+}
+
+// Net balance of a circle member across all split expenses.
+// Positive means others owe this user, negative means they owe.
+export interface CircleBalance {
+    userId: string;
+    balance: number;
+    currency: string;
+}
+
+// Typed projections of source-specific metadata, so clients don't have
+// to guess what's inside the free-form externalData map. Only the view
+// matching the item's source is populated.
+export interface SourceMetadataViews {
+    youtube?: { channel?: string; duration?: string; viewCount?: number };
+    instagram?: { author?: string; postType?: 'post' | 'reel' | 'story' };
+    twitter?: { author?: string; likeCount?: number };
+    tiktok?: { author?: string; duration?: string };
+    spotify?: { show?: string; durationMs?: number; artworkUrl?: string };
+    wikipedia?: { language?: string; summary?: string };
+}
+
+// Describes which typed metadata fields a source can carry,
+// as returned by GET /sources
+export interface SourceDescriptor {
+    source: DataSource;
+    label: string;
+    fields: { name: string; type: 'string' | 'number'; description: string }[];
+}
+
 export interface HobbyItem {
     id: string;
-    title: string;
+    title: string; // Default/original language
     description?: string;
+    localizations?: Record<string, ItemLocalization>;
     categoryId: string; // Single category ID - item belongs to one category in one circle
     categoryConfidence?: number; // AI confidence score (0-1)
     isCompleted: boolean;
     imageUrl?: string;
     source: DataSource;
     sourceUrl?: string;
+    event?: ItemEvent;
+    expense?: ItemExpense;
     addedBy: string; // User ID
+    assigneeId?: string; // Circle member responsible for the item
+    priority?: ItemPriority;
+    status?: ItemStatus;
+    rank?: number; // Manual order within the category, lowest first
+    checklist?: ChecklistEntry[];
     addedAt: Date;
     completedAt?: Date;
+    completionNote?: string; // Free-form note captured when completing
+    archivedAt?: Date; // Tucked away without counting as done
+    snoozedUntil?: Date; // Hidden from listings and reminders until then
     dueDate?: Date;
     tags: string[];
     metadata?: {
@@ -75,6 +310,7 @@ export interface HobbyItem {
             address: string;
         };
         externalData?: Record<string, any>;
+        sources?: SourceMetadataViews;
     };
 }
 
@@ -99,7 +335,11 @@ export interface Circle {
     name: string;
     icon: string;
     description?: string;
+    avatarUrl?: string;
+    color?: string; // Theme accent, hex like '#2563eb'
     ownerId: string;
+    inboxCategoryId?: string; // Default landing category for quick captures and bot/email items
+    archivedAt?: Date; // Soft-deleted; hidden from default listings
     createdAt: Date;
     members: {
         userId: string;
@@ -108,6 +348,172 @@ export interface Circle {
     }[];
 }
 
+// Shareable invite code for a circle. Anyone with the code can join at
+// the configured access level until it expires, is revoked, or hits its
+// usage limit.
+export interface CircleInviteLink {
+    id: string;
+    circleId: string;
+    code: string; // URL-safe, e.g. 'k3J9xQ'
+    accessLevel: AccessLevel;
+    expiresAt?: Date; // Undefined means no expiry
+    maxUses?: number; // Undefined means unlimited
+    useCount: number;
+    revokedAt?: Date;
+    createdAt: Date;
+}
+
+// A circle member with the user profile fields resolved, so clients can
+// render names and avatars instead of bare user IDs
+export interface CircleMemberProfile {
+    userId: string;
+    name: string;
+    avatarUrl?: string;
+    accessLevel: AccessLevel;
+    joinedAt: Date;
+}
+
+// A member's vote on a suggestion: +1 or -1
+export interface SuggestionVote {
+    userId: string;
+    value: 1 | -1;
+    votedAt: Date;
+}
+
+// An item proposed to a circle. Members vote; crossing the promotion
+// threshold (or an admin approving) turns it into a real item in the
+// target category.
+export interface ItemSuggestion {
+    id: string;
+    circleId: string;
+    categoryId: string;
+    title: string;
+    description?: string;
+    suggestedBy: string; // User ID
+    votes: SuggestionVote[];
+    status: 'open' | 'promoted' | 'dismissed';
+    promotedItemId?: string; // Set once promoted
+    createdAt: Date;
+}
+
+// A built-in circle template: creating from one pre-creates its
+// categories and starter tags
+export interface CircleTemplate {
+    id: string;
+    name: string;
+    icon: string;
+    description: string;
+    categories: { name: string; icon: string }[];
+    starterTags: string[];
+}
+
+// A note posted to a circle by an admin ("movie night Friday").
+// Pinned announcements are surfaced above the activity feed.
+export interface CircleAnnouncement {
+    id: string;
+    circleId: string;
+    body: string;
+    isPinned: boolean;
+    postedBy: string; // User ID
+    createdAt: Date;
+    updatedAt: Date;
+}
+
+// One entry in a circle's membership audit log, written for every
+// add/remove/access-change so multiple admins can see who did what
+export interface CircleAuditEntry {
+    id: string;
+    circleId: string;
+    action: 'member-added' | 'member-removed' | 'access-changed';
+    actorId: string; // Who performed the operation
+    targetUserId: string;
+    oldAccessLevel?: AccessLevel;
+    newAccessLevel?: AccessLevel;
+    at: Date;
+}
+
+// Per-entry outcome of a bulk member invitation: existing users are
+// added directly, unknown emails get an invitation
+export interface BulkInviteResult {
+    entry: string; // Email or user ID as submitted
+    status: 'added' | 'invited' | 'already-member' | 'invalid';
+    reason?: string; // Set for 'invalid'
+}
+
+// A user's request to join a circle, awaiting an owner/admin decision
+export interface CircleJoinRequest {
+    id: string;
+    circleId: string;
+    userId: string;
+    message?: string;
+    status: 'pending' | 'approved' | 'rejected';
+    requestedAt: Date;
+    resolvedAt?: Date;
+    resolvedBy?: string; // Owner/admin who decided
+}
+
+// Rough scheduling intent resolved into concrete date suggestions
+// using circle members' availability, locale holidays, and existing plans
+export type ScheduleIntent =
+    | 'next-free-weekend'
+    | 'next-free-evening'
+    | 'next-week'
+    | 'next-month';
+
+export interface DateSuggestion {
+    date: Date;
+    reason: string; // e.g. "Next Saturday, no other plans in Partner circle"
+    conflicts: string[]; // Titles of items already planned near this date
+}
+
+// Two or more items in the same circle planned for the same day
+export interface PlanConflict {
+    circleId: string;
+    date: Date;
+    items: { id: string; title: string }[];
+}
+
+// Persistent per-user import rule applied automatically by all importers:
+// when the imported URL/text matches the pattern, the rule's category and
+// tags are suggested instead of the AI's guess
+export interface ImportRule {
+    id: string;
+    pattern: string; // Substring matched against the URL or text (e.g. 'youtube.com/channel/X')
+    categoryName: string;
+    categoryIcon: string;
+    tags: string[];
+    createdAt: Date;
+}
+
+// An RSS/Atom feed subscribed to a category. The backend poller stages new
+// entries (title, link, image) for review; the keyword filters are applied
+// per feed before anything is staged.
+export interface FeedSubscription {
+    id: string;
+    categoryId: string;
+    feedUrl: string;
+    title?: string; // Taken from the feed itself on first fetch
+    includeKeywords: string[]; // Empty means every entry passes
+    excludeKeywords: string[];
+    lastFetchedAt?: Date;
+    createdAt: Date;
+}
+
+// A saved external source (YouTube playlist, RSS feed, public list export)
+// that the backend job scheduler re-polls on a schedule. Only entries newer
+// than the last poll are imported; they land in the staging area for review.
+export interface SavedImportSource {
+    id: string;
+    name: string;
+    url: string;
+    source: DataSource;
+    schedule: 'hourly' | 'daily' | 'weekly';
+    enabled: boolean;
+    lastPolledAt?: Date;
+    lastImportedCount: number;
+    createdAt: Date;
+}
+
 export interface ImportRequest {
     source: DataSource;
     url?: string;
@@ -116,6 +522,48 @@ export interface ImportRequest {
     suggestedTags?: string[];
 }
 
+// Bulk watchlist import from popular list exports, processed as an
+// asynchronous import job on the backend
+export type WatchlistFormat = 'letterboxd' | 'goodreads' | 'imdb';
+
+export interface WatchlistImportJob {
+    id: string;
+    format: WatchlistFormat;
+    status: 'pending' | 'processing' | 'completed' | 'failed';
+    totalRows: number;
+    importedCount: number;
+    errors: string[];
+}
+
+// An imported entry waiting in the staging area. Bulk importers land
+// items here first; the user accepts/rejects/edits them and then commits.
+export interface StagedImportItem {
+    id: string;
+    jobId: string; // Import job that produced this entry
+    item: Partial<HobbyItem>;
+    decision: 'pending' | 'accepted' | 'rejected';
+}
+
+// Read-later service whose export can be migrated into a "to read" category
+export type ReadLaterSource = 'pocket' | 'instapaper';
+
+export interface ReadLaterImportResult {
+    jobId: string;
+    source: ReadLaterSource;
+    totalEntries: number;
+    importedCount: number;
+    errors: string[];
+}
+
+// Result of importing a Google Takeout saved-places export
+export interface SavedPlacesImportResult {
+    jobId: string;
+    totalPlaces: number;
+    importedCount: number;
+    skippedDuplicates: number;
+    errors: string[];
+}
+
 export interface ImportResult {
     success: boolean;
     item?: Partial<HobbyItem>;
@@ -129,6 +577,135 @@ export interface ImportResult {
     error?: string;
 }
 
+// Asynchronous GDPR subject-access export job.
+// The package (JSON + attachments manifest, signed and encrypted to the
+// provided public key) is generated by the backend job system.
+export interface DataExportJob {
+    id: string;
+    status: 'pending' | 'processing' | 'completed' | 'failed';
+    requestedAt: Date;
+    completedAt?: Date;
+    downloadUrl?: string; // Available once status is 'completed'
+    error?: string;
+}
+
+// State of the per-user external calendar connection.
+// The backend keeps a sync-state store mapping items to calendar events
+// so due-date changes propagate in both directions.
+export interface CalendarSyncStatus {
+    connected: boolean;
+    provider?: 'google';
+    lastSyncedAt?: Date;
+    syncedItemCount: number;
+}
+
+export type SubscriptionTier = 'free' | 'supporter';
+
+// What the user's tier unlocks. Enforced server-side; the frontend
+// reads it to hide or soft-lock gated features.
+export interface Entitlements {
+    aiSuggestions: boolean;
+    attachmentStorageMb: number;
+    maxCircles: number;
+}
+
+// The user's subscription as mirrored from the billing provider.
+// Lifecycle changes arrive via Stripe webhooks; this is read-only here.
+export interface Subscription {
+    tier: SubscriptionTier;
+    status: 'active' | 'past_due' | 'canceled';
+    renewsAt?: Date;
+    cancelAtPeriodEnd: boolean;
+    entitlements: Entitlements;
+}
+
+// Scope limiting what a personal API key may do
+export type ApiKeyScope = 'read' | 'write' | 'items-only';
+
+// Personal API key for programmatic access (scripts, Telegram bot, etc.).
+// The secret is stored hashed server-side; only the prefix is readable later.
+export interface ApiKey {
+    id: string;
+    name: string;
+    scopes: ApiKeyScope[];
+    prefix: string; // First characters of the key, for identification
+    createdAt: Date;
+    lastUsedAt?: Date;
+}
+
+// Payload posted by the Telegram Login Widget. The backend verifies the
+// hash against the bot token before creating or linking a user.
+export interface TelegramLoginPayload {
+    id: number;
+    first_name: string;
+    last_name?: string;
+    username?: string;
+    photo_url?: string;
+    auth_date: number; // Unix seconds; rejected server-side when too old
+    hash: string;
+}
+
+export type AuthAuditEventType =
+    | 'login'
+    | 'login-failed'
+    | 'password-change'
+    | 'oauth-link'
+    | 'token-refresh';
+
+// One entry in the security audit trail for an account. Written by the
+// backend auth handlers; users can review their own recent activity.
+export interface AuthAuditEvent {
+    id: string;
+    type: AuthAuditEventType;
+    at: Date;
+    ip?: string;
+    userAgent?: string;
+    detail?: string; // e.g. provider name for oauth-link
+}
+
+// Per-user preferences document. Reminders and digests use the timezone
+// and locale to localize times; the defaults prefill quick captures.
+export interface UserPreferences {
+    timezone: string; // IANA name, e.g. 'Europe/Berlin'
+    locale: string; // BCP-47, e.g. 'en-US'
+    weekStartsOn: 0 | 1 | 6; // Sunday, Monday, or Saturday
+    defaultCircleId?: string;
+    defaultCategoryId?: string;
+    notifications: {
+        emailDigest: boolean;
+        dueDateReminders: boolean;
+    };
+}
+
+export type TriageAction = 'categorize' | 'tag' | 'snooze' | 'delete';
+
+// One decision in a batch triage stream. Fields beyond itemId apply to
+// the matching action only.
+export interface TriageDecision {
+    itemId: string;
+    action: TriageAction;
+    categoryId?: string; // categorize
+    tags?: string[]; // tag (appended to existing tags)
+    snoozeUntil?: Date; // snooze
+}
+
+// Result of a triage batch. Decisions are applied transactionally:
+// if any item is missing the whole batch is rejected.
+export interface TriageSummary {
+    categorized: number;
+    tagged: number;
+    snoozed: number;
+    deleted: number;
+}
+
+// Operator-facing deployment statistics, admin-only
+export interface SystemStats {
+    userCount: number;
+    itemCount: number;
+    circleCount: number;
+    itemsAddedLast7Days: number;
+}
+
 export interface AuthState {
     user: User | null;
     isAuthenticated: boolean;
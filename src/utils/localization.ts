@@ -0,0 +1,55 @@
+// Locale-aware selection of item text fields.
+//
+// Items can carry optional per-language title/description variants
+// (useful in bilingual households). Selection walks the user's preferred
+// languages in order, matching exact tags first and then base languages
+// ('de-AT' falls back to 'de'), and finally the item's original text.
+
+import { HobbyItem } from '../types';
+
+function preferredLanguages(): string[] {
+  return [...(navigator.languages || [navigator.language || 'en'])];
+}
+
+function pickLocalization<K extends 'title' | 'description'>(
+  item: HobbyItem,
+  field: K,
+  languages: string[]
+): string | undefined {
+  if (!item.localizations) return undefined;
+
+  for (const lang of languages) {
+    // Exact match (case-insensitive, per BCP-47)
+    const exactKey = Object.keys(item.localizations).find(
+      key => key.toLowerCase() === lang.toLowerCase()
+    );
+    const exact = exactKey ? item.localizations[exactKey][field] : undefined;
+    if (exact) return exact;
+
+    // Base language match ('de-AT' -> 'de')
+    const base = lang.split('-')[0].toLowerCase();
+    const baseKey = Object.keys(item.localizations).find(
+      key => key.split('-')[0].toLowerCase() === base
+    );
+    const fallback = baseKey ? item.localizations[baseKey][field] : undefined;
+    if (fallback) return fallback;
+  }
+
+  return undefined;
+}
+
+/**
+ * Get the item title in the user's preferred language,
+ * falling back to the original title
+ */
+export function getLocalizedTitle(item: HobbyItem, languages: string[] = preferredLanguages()): string {
+  return pickLocalization(item, 'title', languages) || item.title;
+}
+
+/**
+ * Get the item description in the user's preferred language,
+ * falling back to the original description
+ */
+export function getLocalizedDescription(item: HobbyItem, languages: string[] = preferredLanguages()): string | undefined {
+  return pickLocalization(item, 'description', languages) || item.description;
+}
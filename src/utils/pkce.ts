@@ -0,0 +1,64 @@
+// PKCE (RFC 7636) helpers for the OAuth authorization-code flow.
+//
+// As a public client the web app cannot hold a client secret, so each
+// authorization request carries a hashed code_challenge and the token
+// exchange proves possession of the original code_verifier. The verifier
+// is persisted alongside the state parameter so it survives the redirect
+// round-trip to the provider.
+
+const PKCE_STORAGE_KEY = 'oauth_pkce';
+
+function base64UrlEncode(bytes: Uint8Array): string {
+  let binary = '';
+  bytes.forEach((byte) => {
+    binary += String.fromCharCode(byte);
+  });
+  return btoa(binary).replace(/\+/g, '-').replace(/\//g, '_').replace(/=+$/, '');
+}
+
+/**
+ * Generate a cryptographically random code verifier (43-128 chars per spec)
+ */
+export function generateCodeVerifier(): string {
+  const bytes = new Uint8Array(48);
+  crypto.getRandomValues(bytes);
+  return base64UrlEncode(bytes);
+}
+
+/**
+ * Compute the S256 code challenge for a verifier
+ */
+export async function computeCodeChallenge(verifier: string): Promise<string> {
+  const digest = await crypto.subtle.digest('SHA-256', new TextEncoder().encode(verifier));
+  return base64UrlEncode(new Uint8Array(digest));
+}
+
+/**
+ * Start a PKCE session: generates verifier, challenge, and state,
+ * and persists the verifier keyed by state for the callback leg
+ */
+export async function createPkceSession(): Promise<{ challenge: string; state: string }> {
+  const verifier = generateCodeVerifier();
+  const challenge = await computeCodeChallenge(verifier);
+  const state = base64UrlEncode(crypto.getRandomValues(new Uint8Array(16)));
+
+  const sessions = JSON.parse(sessionStorage.getItem(PKCE_STORAGE_KEY) || '{}');
+  sessions[state] = verifier;
+  sessionStorage.setItem(PKCE_STORAGE_KEY, JSON.stringify(sessions));
+
+  return { challenge, state };
+}
+
+/**
+ * Retrieve and remove the verifier stored for a state parameter.
+ * Returns null if the state is unknown (possible CSRF or expired session).
+ */
+export function consumePkceVerifier(state: string): string | null {
+  const sessions = JSON.parse(sessionStorage.getItem(PKCE_STORAGE_KEY) || '{}');
+  const verifier = sessions[state] || null;
+  if (verifier) {
+    delete sessions[state];
+    sessionStorage.setItem(PKCE_STORAGE_KEY, JSON.stringify(sessions));
+  }
+  return verifier;
+}